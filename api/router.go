@@ -1,9 +1,24 @@
 package api
 
-import "net/http"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/sheaksadi/verteilte/service"
+)
+
+var translatorRegistry = service.NewRegistry()
+var dictionaryRegistry = service.NewDictionaryRegistry()
 
 func Setup(handler *http.ServeMux) {
 	handler.HandleFunc("GET /api/test", TestHandler)
+	handler.HandleFunc("GET /api/translate", TranslateHandler)
+	handler.HandleFunc("GET /api/dictionary", DictionaryHandler)
+	handler.HandleFunc("GET /api/detect", DetectHandler)
+	handler.HandleFunc("GET /api/cache/stats", CacheStatsHandler)
+	handler.HandleFunc("DELETE /api/cache", CacheClearHandler)
+	handler.HandleFunc("GET /api/gemini/stream", GeminiStreamHandler)
 }
 
 func TestHandler(w http.ResponseWriter, r *http.Request) {
@@ -11,3 +26,169 @@ func TestHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(`{"status": "success", "message": "API test endpoint working"}`))
 }
+
+// TranslateHandler dispatches GET /api/translate?engine=&from=&to=&q= to the
+// requested engine, falling back through the other registered engines if it
+// fails. When from is "auto" or empty, the source language is detected
+// first; if the detected (or given) source already matches to, the
+// translation round-trip is skipped entirely.
+func TranslateHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	text := q.Get("q")
+	if text == "" {
+		http.Error(w, "missing required query param: q", http.StatusBadRequest)
+		return
+	}
+
+	engine := q.Get("engine")
+	from := q.Get("from")
+	to := q.Get("to")
+
+	if from == "" || from == "auto" {
+		guesses, err := service.DetectLanguage(r.Context(), text)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		from = guesses[0].Language
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if from == to {
+		json.NewEncoder(w).Encode(service.Result{
+			TranslatedText: text,
+			DetectedSource: from,
+			EngineUsed:     "none",
+		})
+		return
+	}
+
+	result, err := translatorRegistry.Translate(r.Context(), engine, text, from, to)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	if result.DetectedSource == "" {
+		result.DetectedSource = from
+	}
+
+	json.NewEncoder(w).Encode(result)
+}
+
+// DetectHandler serves GET /api/detect?q=, returning ranked language
+// guesses for the given text.
+func DetectHandler(w http.ResponseWriter, r *http.Request) {
+	text := r.URL.Query().Get("q")
+	if text == "" {
+		http.Error(w, "missing required query param: q", http.StatusBadRequest)
+		return
+	}
+
+	guesses, err := service.DetectLanguage(r.Context(), text)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(guesses)
+}
+
+// DictionaryHandler serves GET /api/dictionary?word=&lang=, looking the word
+// up across the registered dictionary providers and, when possible,
+// appending a freshly generated example sentence from Gemini to the first
+// explanation.
+func DictionaryHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	word := q.Get("word")
+	lang := q.Get("lang")
+	if word == "" || lang == "" {
+		http.Error(w, "missing required query params: word, lang", http.StatusBadRequest)
+		return
+	}
+
+	words, err := dictionaryRegistry.Lookup(r.Context(), word, lang)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	if example, err := service.GenerateExampleSentence(r.Context(), word, lang); err == nil {
+		attachGeneratedExample(words, example)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		Words []service.Word `json:"words"`
+	}{Words: words})
+}
+
+// CacheStatsHandler serves GET /api/cache/stats, reporting the item count
+// and total size of the on-disk translation/TTS cache.
+func CacheStatsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(service.CacheStats())
+}
+
+// CacheClearHandler serves DELETE /api/cache, emptying the on-disk
+// translation/TTS cache.
+func CacheClearHandler(w http.ResponseWriter, r *http.Request) {
+	if err := service.ClearCache(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GeminiStreamHandler serves GET /api/gemini/stream?q=, relaying Gemini's
+// streamed response to the browser as Server-Sent Events.
+func GeminiStreamHandler(w http.ResponseWriter, r *http.Request) {
+	prompt := r.URL.Query().Get("q")
+	if prompt == "" {
+		http.Error(w, "missing required query param: q", http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	cfg, err := service.LoadGeminiConfig()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	deltas, errCh := service.NewGeminiClient(cfg).StreamGenerate(r.Context(), prompt)
+	for delta := range deltas {
+		fmt.Fprintf(w, "data: %s\n\n", delta)
+		flusher.Flush()
+	}
+
+	if err := <-errCh; err != nil {
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", err.Error())
+		flusher.Flush()
+	}
+}
+
+func attachGeneratedExample(words []service.Word, sentence string) {
+	for i := range words {
+		for j := range words[i].POSList {
+			if len(words[i].POSList[j].Explanations) == 0 {
+				continue
+			}
+			words[i].POSList[j].Explanations[0].Examples = append(
+				words[i].POSList[j].Explanations[0].Examples,
+				service.Example{Sentences: []service.Sentence{{Text: sentence}}},
+			)
+			return
+		}
+	}
+}
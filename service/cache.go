@@ -0,0 +1,252 @@
+package service
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// CacheConfig configures an on-disk Cache.
+type CacheConfig struct {
+	Dir      string
+	MaxItems int
+	MaxBytes int64
+	TTL      time.Duration
+}
+
+type cacheEntry struct {
+	key       string
+	path      string
+	size      int64
+	expiresAt time.Time
+}
+
+// Cache is an LRU index over JSON/binary blobs persisted under Dir. It
+// bounds both the number of entries and their total size, evicting the
+// least-recently-used entry when either limit would be exceeded.
+type Cache struct {
+	dir      string
+	maxItems int
+	maxBytes int64
+	ttl      time.Duration
+
+	mu        sync.Mutex
+	list      *list.List
+	index     map[string]*list.Element
+	totalSize int64
+}
+
+// NewCache creates a Cache rooted at cfg.Dir, creating the directory if
+// necessary.
+func NewCache(cfg CacheConfig) (*Cache, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("cache: create dir error: %w", err)
+	}
+	return &Cache{
+		dir:      cfg.Dir,
+		maxItems: cfg.MaxItems,
+		maxBytes: cfg.MaxBytes,
+		ttl:      cfg.TTL,
+		list:     list.New(),
+		index:    make(map[string]*list.Element),
+	}, nil
+}
+
+// TranslationKey derives the cache key for a translation lookup.
+func TranslationKey(engine, source, target, text string) string {
+	return hashKey(engine, source, target, text)
+}
+
+// TTSKey derives the cache key for a TTS lookup.
+func TTSKey(voice, lang, text string) string {
+	return hashKey(voice, lang, text)
+}
+
+// DictionaryKey derives the cache key for a dictionary lookup.
+func DictionaryKey(provider, word, lang string) string {
+	return hashKey(provider, word, lang)
+}
+
+func hashKey(parts ...string) string {
+	h := sha256.New()
+	for i, p := range parts {
+		if i > 0 {
+			h.Write([]byte{'|'})
+		}
+		h.Write([]byte(p))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Get reads the raw bytes stored under key. ok is false on a miss or an
+// expired entry.
+func (c *Cache) Get(key string) (data []byte, ok bool) {
+	c.mu.Lock()
+	el, found := c.index[key]
+	if !found {
+		c.mu.Unlock()
+		return nil, false
+	}
+	entry := el.Value.(*cacheEntry)
+	if c.ttl > 0 && time.Now().After(entry.expiresAt) {
+		c.removeElement(el)
+		c.mu.Unlock()
+		return nil, false
+	}
+	c.list.MoveToFront(el)
+	path := entry.path
+	c.mu.Unlock()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// GetJSON reads and unmarshals the cached value for key into v.
+func (c *Cache) GetJSON(key string, v any) bool {
+	data, ok := c.Get(key)
+	if !ok {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// Set writes data under key, evicting least-recently-used entries until the
+// cache fits within MaxItems/MaxBytes.
+func (c *Cache) Set(key string, data []byte) error {
+	if c.dir == "" {
+		return fmt.Errorf("cache: no directory configured")
+	}
+	path := filepath.Join(c.dir, key)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("cache: write error: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{key: key, path: path, size: int64(len(data))}
+	if c.ttl > 0 {
+		entry.expiresAt = time.Now().Add(c.ttl)
+	}
+
+	// Overwriting a live key: update the existing element in place rather
+	// than going through removeElement, which would os.Remove the path we
+	// just wrote (key's path is a pure function of key, so old and new
+	// paths are identical).
+	if el, found := c.index[key]; found {
+		old := el.Value.(*cacheEntry)
+		c.totalSize -= old.size
+		el.Value = entry
+		c.list.MoveToFront(el)
+		c.totalSize += entry.size
+		c.evict()
+		return nil
+	}
+
+	el := c.list.PushFront(entry)
+	c.index[key] = el
+	c.totalSize += entry.size
+
+	c.evict()
+	return nil
+}
+
+// SetJSON marshals v and stores it under key.
+func (c *Cache) SetJSON(key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("cache: JSON marshal error: %w", err)
+	}
+	return c.Set(key, data)
+}
+
+func (c *Cache) evict() {
+	for (c.maxItems > 0 && c.list.Len() > c.maxItems) || (c.maxBytes > 0 && c.totalSize > c.maxBytes) {
+		oldest := c.list.Back()
+		if oldest == nil {
+			return
+		}
+		c.removeElement(oldest)
+	}
+}
+
+func (c *Cache) removeElement(el *list.Element) {
+	entry := el.Value.(*cacheEntry)
+	os.Remove(entry.path)
+	c.totalSize -= entry.size
+	c.list.Remove(el)
+	delete(c.index, entry.key)
+}
+
+// Stats summarizes the current cache state for the /api/cache/stats
+// endpoint.
+type Stats struct {
+	Items int   `json:"items"`
+	Bytes int64 `json:"bytes"`
+}
+
+// Stats reports the current item count and total size of the cache.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{Items: c.list.Len(), Bytes: c.totalSize}
+}
+
+// Clear removes every entry from the cache, both on disk and in the index.
+func (c *Cache) Clear() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, el := range c.index {
+		entry := el.Value.(*cacheEntry)
+		os.Remove(entry.path)
+		delete(c.index, key)
+	}
+	c.list.Init()
+	c.totalSize = 0
+	return nil
+}
+
+var (
+	defaultCacheOnce sync.Once
+	defaultCache     *Cache
+)
+
+// getDefaultCache lazily initializes the process-wide cache used by
+// translation and TTS helpers, rooted at CACHE_DIR (default "./cache").
+func getDefaultCache() *Cache {
+	defaultCacheOnce.Do(func() {
+		dir := os.Getenv("CACHE_DIR")
+		if dir == "" {
+			dir = "./cache"
+		}
+
+		c, err := NewCache(CacheConfig{
+			Dir:      dir,
+			MaxItems: 10000,
+			MaxBytes: 500 * 1024 * 1024,
+			TTL:      24 * time.Hour,
+		})
+		if err != nil {
+			// Fall back to an index that never hits, so the cache being
+			// unwritable doesn't break callers, just leaves them uncached.
+			c = &Cache{list: list.New(), index: make(map[string]*list.Element)}
+		}
+		defaultCache = c
+	})
+	return defaultCache
+}
+
+// CacheStats reports on the process-wide default cache.
+func CacheStats() Stats { return getDefaultCache().Stats() }
+
+// ClearCache empties the process-wide default cache.
+func ClearCache() error { return getDefaultCache().Clear() }
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context/ctxhttp"
+	"golang.org/x/time/rate"
+)
+
+// Client wraps a shared *http.Client with context-aware requests,
+// exponential-backoff retries on 5xx/429 responses, and per-engine rate
+// limiting so the free scraping endpoints in this package don't get us
+// banned.
+type Client struct {
+	HTTPClient *http.Client
+	MaxRetries int
+	limiters   map[string]*rate.Limiter
+}
+
+// DefaultClient is the Client used throughout the service package unless a
+// caller supplies its own.
+var DefaultClient = NewClient()
+
+// NewClient returns a Client with a 30s timeout transport, 3 retries, and a
+// conservative default rate limit for each known engine.
+func NewClient() *Client {
+	c := &Client{
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		MaxRetries: 3,
+		limiters:   make(map[string]*rate.Limiter),
+	}
+	for _, engine := range []string{"google", "libre", "reverso", "yandex", "bing", "gemini", "wiktionary", "volcano"} {
+		c.Limit(engine, 2, 2)
+	}
+	return c
+}
+
+// Limit registers a requests-per-second limit (with burst) for a named
+// engine. Calls to Do for that engine block on Wait until a token is
+// available.
+func (c *Client) Limit(engine string, rps float64, burst int) {
+	c.limiters[engine] = rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// WithTransport swaps the underlying RoundTripper, letting callers inject a
+// proxying, TLS-customized, or mocked transport (e.g. in tests).
+func (c *Client) WithTransport(rt http.RoundTripper) *Client {
+	c.HTTPClient.Transport = rt
+	return c
+}
+
+// Do sends req through ctxhttp so handler cancellation propagates to the
+// upstream call, retrying with exponential backoff and jitter on 5xx and
+// 429 responses. engine selects the rate limiter to wait on, if one was
+// registered via Limit.
+func (c *Client) Do(ctx context.Context, engine string, req *http.Request) (*http.Response, error) {
+	if limiter, ok := c.limiters[engine]; ok {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleepWithContext(ctx, backoff(attempt)); err != nil {
+				return nil, err
+			}
+			// The previous attempt consumed the body; rewind it for the retry.
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				req.Body = body
+			}
+		}
+
+		resp, err := ctxhttp.Do(ctx, c.HTTPClient, req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			return resp, nil
+		}
+
+		lastErr = fmt.Errorf("request returned status: %s", resp.Status)
+		resp.Body.Close()
+	}
+
+	return nil, lastErr
+}
+
+func backoff(attempt int) time.Duration {
+	base := time.Duration(1<<uint(attempt)) * 200 * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(base)/2 + 1))
+	return base + jitter
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newResponse(status int) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+}
+
+func TestClientDoRetriesOnTooManyRequestsThenSucceeds(t *testing.T) {
+	var attempts int
+	c := NewClient().WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts < 3 {
+			return newResponse(http.StatusTooManyRequests), nil
+		}
+		return newResponse(http.StatusOK), nil
+	}))
+	c.MaxRetries = 3
+
+	req, err := http.NewRequest("GET", "http://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("create request error: %v", err)
+	}
+
+	resp, err := c.Do(context.Background(), "test", req)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected final status 200, got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClientDoGivesUpAfterMaxRetries(t *testing.T) {
+	var attempts int
+	c := NewClient().WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return newResponse(http.StatusInternalServerError), nil
+	}))
+	c.MaxRetries = 2
+
+	req, err := http.NewRequest("GET", "http://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("create request error: %v", err)
+	}
+
+	_, err = c.Do(context.Background(), "test", req)
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries, got nil")
+	}
+	if want := c.MaxRetries + 1; attempts != want {
+		t.Errorf("expected %d attempts, got %d", want, attempts)
+	}
+}
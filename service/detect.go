@@ -0,0 +1,137 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// LanguageGuess is one candidate language returned by a detection backend,
+// ranked by Confidence (0-1).
+type LanguageGuess struct {
+	Language   string  `json:"language"`
+	Confidence float64 `json:"confidence"`
+}
+
+// DetectLanguage guesses the language of text, trying LibreTranslate's
+// /detect endpoint first and falling back to Google's translate_a/single
+// endpoint (requested with dt=ld) if Libre is unavailable.
+func DetectLanguage(ctx context.Context, text string) ([]LanguageGuess, error) {
+	guesses, err := detectLanguageLibre(ctx, text)
+	if err == nil && len(guesses) > 0 {
+		return guesses, nil
+	}
+
+	fallbackGuesses, fallbackErr := detectLanguageGoogle(ctx, text)
+	if fallbackErr == nil && len(fallbackGuesses) > 0 {
+		return fallbackGuesses, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return nil, fallbackErr
+}
+
+func detectLanguageLibre(ctx context.Context, text string) ([]LanguageGuess, error) {
+	apiURL := "http://deadhorse.net:5000/detect"
+	payload := map[string]any{"q": text}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("detect(libre): JSON marshal error: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("detect(libre): create request error: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := DefaultClient.Do(ctx, "libre", req)
+	if err != nil {
+		return nil, fmt.Errorf("detect(libre): request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("detect(libre): read response error: %w", err)
+	}
+
+	var results []struct {
+		Language   string  `json:"language"`
+		Confidence float64 `json:"confidence"`
+	}
+	if err := json.Unmarshal(body, &results); err != nil {
+		return nil, fmt.Errorf("detect(libre): JSON unmarshal error: %w", err)
+	}
+
+	guesses := make([]LanguageGuess, 0, len(results))
+	for _, r := range results {
+		guesses = append(guesses, LanguageGuess{Language: r.Language, Confidence: r.Confidence / 100})
+	}
+	return guesses, nil
+}
+
+func detectLanguageGoogle(ctx context.Context, text string) ([]LanguageGuess, error) {
+	baseURL := "https://translate.google.com/translate_a/single"
+	params := url.Values{
+		"client": {"gtx"},
+		"sl":     {"auto"},
+		"tl":     {"en"},
+		"dt":     {"ld"},
+		"ie":     {"UTF-8"},
+		"oe":     {"UTF-8"},
+		"q":      {text},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s?%s", baseURL, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("detect(google): create request error: %w", err)
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
+
+	resp, err := DefaultClient.Do(ctx, "google", req)
+	if err != nil {
+		return nil, fmt.Errorf("detect(google): request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("detect(google): returned status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("detect(google): read response error: %w", err)
+	}
+
+	var raw []interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("detect(google): JSON unmarshal error: %w", err)
+	}
+
+	// dt=ld appends the detected language code as a short string near the
+	// end of the top-level array; Google does not document its exact
+	// index, so scan from the back for the first plausible language code.
+	lang, ok := extractGoogleDetectedLanguage(raw)
+	if !ok {
+		return nil, fmt.Errorf("detect(google): could not locate detected language in response")
+	}
+
+	return []LanguageGuess{{Language: lang, Confidence: 1}}, nil
+}
+
+func extractGoogleDetectedLanguage(raw []interface{}) (string, bool) {
+	for i := len(raw) - 1; i >= 0; i-- {
+		if s, ok := raw[i].(string); ok && len(s) >= 2 && len(s) <= 5 {
+			return s, true
+		}
+	}
+	return "", false
+}
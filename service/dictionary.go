@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// Word is a single dictionary entry, mirroring the shape returned by most
+// online dictionaries.
+type Word struct {
+	Text    string         `json:"text"`
+	POSList []PartOfSpeech `json:"posList"`
+}
+
+// PartOfSpeech groups the phonetics and explanations that apply to one
+// part of speech (noun, verb, ...) of a Word.
+type PartOfSpeech struct {
+	Type         string        `json:"type"`
+	Phonetics    []Phonetic    `json:"phonetics,omitempty"`
+	Explanations []Explanation `json:"explanations"`
+}
+
+// Phonetic is a single pronunciation rendering, e.g. IPA or a pinyin
+// transliteration.
+type Phonetic struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Explanation is one sense of a PartOfSpeech, optionally illustrated with
+// example sentences and synonyms.
+type Explanation struct {
+	Text     string    `json:"text"`
+	Examples []Example `json:"examples,omitempty"`
+	Synonyms []string  `json:"synonyms,omitempty"`
+}
+
+// Example is a group of sentences illustrating an Explanation.
+type Example struct {
+	Sentences []Sentence `json:"sentences"`
+}
+
+// Sentence is one example sentence, with an optional translation.
+type Sentence struct {
+	Text      string `json:"text"`
+	TransText string `json:"transText,omitempty"`
+}
+
+// Dictionary is implemented by dictionary lookup backends.
+type Dictionary interface {
+	Name() string
+	Lookup(ctx context.Context, word, lang string) ([]Word, error)
+}
+
+// DictionaryRegistry holds dictionary providers and falls back from one to
+// the next when a lookup comes back empty, mirroring Registry's behavior
+// for translation engines.
+type DictionaryRegistry struct {
+	providers []Dictionary
+}
+
+// NewDictionaryRegistry returns a DictionaryRegistry pre-populated with the
+// built-in providers.
+func NewDictionaryRegistry() *DictionaryRegistry {
+	return &DictionaryRegistry{
+		providers: []Dictionary{
+			&WiktionaryDictionary{},
+			&VolcanoDictionary{},
+		},
+	}
+}
+
+// Lookup tries each registered provider in order, returning the first
+// non-empty result. Each provider's non-empty result is cached against the
+// process-wide default cache, keyed by provider, word, and lang; failed or
+// empty lookups are not cached, so on a repeated lookup any provider that
+// failed before the serving one is called over the network again.
+func (r *DictionaryRegistry) Lookup(ctx context.Context, word, lang string) ([]Word, error) {
+	var lastErr error
+	for _, p := range r.providers {
+		key := DictionaryKey(p.Name(), word, lang)
+
+		var cached []Word
+		if getDefaultCache().GetJSON(key, &cached) && len(cached) > 0 {
+			return cached, nil
+		}
+
+		words, err := p.Lookup(ctx, word, lang)
+		if err == nil && len(words) > 0 {
+			getDefaultCache().SetJSON(key, words)
+			return words, nil
+		}
+		if err != nil {
+			lastErr = err
+		}
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no dictionary entries found for %q", word)
+	}
+	return nil, lastErr
+}
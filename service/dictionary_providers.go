@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// WiktionaryDictionary talks to Wiktionary's mobile REST API, which returns
+// a word's definitions grouped by part of speech as small blocks of HTML.
+type WiktionaryDictionary struct{}
+
+func (WiktionaryDictionary) Name() string { return "wiktionary" }
+
+var wiktionaryTag = regexp.MustCompile(`<[^>]+>`)
+
+func (WiktionaryDictionary) Lookup(ctx context.Context, word, lang string) ([]Word, error) {
+	apiURL := fmt.Sprintf("https://%s.wiktionary.org/api/rest_v1/page/definition/%s", lang, url.PathEscape(word))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("wiktionary: create request error: %w", err)
+	}
+
+	resp, err := DefaultClient.Do(ctx, "wiktionary", req)
+	if err != nil {
+		return nil, fmt.Errorf("wiktionary: request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("wiktionary: returned status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("wiktionary: read response error: %w", err)
+	}
+
+	var raw map[string][]struct {
+		PartOfSpeech string `json:"partOfSpeech"`
+		Definitions  []struct {
+			Definition string   `json:"definition"`
+			Examples   []string `json:"examples"`
+		} `json:"definitions"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("wiktionary: JSON unmarshal error: %w", err)
+	}
+
+	entries, ok := raw[lang]
+	if !ok || len(entries) == 0 {
+		return nil, fmt.Errorf("wiktionary: no entries for language %q", lang)
+	}
+
+	w := Word{Text: word}
+	for _, entry := range entries {
+		pos := PartOfSpeech{Type: entry.PartOfSpeech}
+		for _, def := range entry.Definitions {
+			explanation := Explanation{Text: stripHTML(def.Definition)}
+			for _, ex := range def.Examples {
+				explanation.Examples = append(explanation.Examples, Example{
+					Sentences: []Sentence{{Text: stripHTML(ex)}},
+				})
+			}
+			pos.Explanations = append(pos.Explanations, explanation)
+		}
+		w.POSList = append(w.POSList, pos)
+	}
+
+	return []Word{w}, nil
+}
+
+func stripHTML(s string) string {
+	return wiktionaryTag.ReplaceAllString(s, "")
+}
+
+// VolcanoDictionary talks to a Volcano/Caiyun-style JSON dictionary API
+// that, unlike Wiktionary's REST API, also returns phonetics alongside
+// definitions and synonyms.
+type VolcanoDictionary struct{}
+
+func (VolcanoDictionary) Name() string { return "volcano" }
+
+func (VolcanoDictionary) Lookup(ctx context.Context, word, lang string) ([]Word, error) {
+	apiURL := "https://fanyi.caiyunapp.com/dict"
+	params := url.Values{"word": {word}, "lang": {lang}}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s?%s", apiURL, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("volcano: create request error: %w", err)
+	}
+
+	resp, err := DefaultClient.Do(ctx, "volcano", req)
+	if err != nil {
+		return nil, fmt.Errorf("volcano: request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("volcano: returned status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("volcano: read response error: %w", err)
+	}
+
+	var parsed struct {
+		Dictionary struct {
+			Word     string `json:"word"`
+			Phonetic string `json:"phonetic"`
+			Relateds []struct {
+				Pos      string   `json:"pos"`
+				Means    []string `json:"means"`
+				Synonyms []string `json:"synonym"`
+			} `json:"relateds"`
+		} `json:"dictionary"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, fmt.Errorf("volcano: JSON unmarshal error: %w", err)
+	}
+	if parsed.Dictionary.Word == "" {
+		return nil, fmt.Errorf("volcano: no entry for %q", word)
+	}
+
+	w := Word{Text: parsed.Dictionary.Word}
+	for _, rel := range parsed.Dictionary.Relateds {
+		pos := PartOfSpeech{Type: rel.Pos}
+		if parsed.Dictionary.Phonetic != "" {
+			pos.Phonetics = []Phonetic{{Type: "IPA", Text: parsed.Dictionary.Phonetic}}
+		}
+		for _, mean := range rel.Means {
+			pos.Explanations = append(pos.Explanations, Explanation{Text: mean, Synonyms: rel.Synonyms})
+		}
+		w.POSList = append(w.POSList, pos)
+	}
+
+	return []Word{w}, nil
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// GoogleEngine adapts GoogleTranslateText to the Translator interface.
+type GoogleEngine struct{}
+
+func (GoogleEngine) Name() string { return "google" }
+
+func (GoogleEngine) Translate(ctx context.Context, text, source, target string) (Result, error) {
+	translated, err := GoogleTranslateText(ctx, nil, text, source, target)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{TranslatedText: translated, EngineUsed: "google"}, nil
+}
+
+// LibreEngine adapts LibreTranslateText to the Translator interface.
+type LibreEngine struct{}
+
+func (LibreEngine) Name() string { return "libre" }
+
+func (LibreEngine) Translate(ctx context.Context, text, source, target string) (Result, error) {
+	translated, err := LibreTranslateText(ctx, nil, text, source, target)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{TranslatedText: translated, EngineUsed: "libre"}, nil
+}
+
+// ReversoEngine talks to Reverso Context's translation endpoint.
+//
+// Reverso expects a JSON POST body of the form:
+//
+//	{"format":"text","from":"eng","to":"fra","input":"hello","options":{"sentenceSplitter":false,"origin":"translation.web","contextResults":false}}
+//
+// and returns the translation plus any alternative renderings it found.
+type ReversoEngine struct{}
+
+func (ReversoEngine) Name() string { return "reverso" }
+
+func (ReversoEngine) Translate(ctx context.Context, text, source, target string) (Result, error) {
+	apiURL := "https://api.reverso.net/translate/v1/translation"
+
+	payload := map[string]any{
+		"format": "text",
+		"from":   source,
+		"to":     target,
+		"input":  text,
+		"options": map[string]any{
+			"sentenceSplitter": false,
+			"origin":           "translation.web",
+			"contextResults":   false,
+		},
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return Result{}, fmt.Errorf("reverso: JSON marshal error: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return Result{}, fmt.Errorf("reverso: create request error: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := DefaultClient.Do(ctx, "reverso", req)
+	if err != nil {
+		return Result{}, fmt.Errorf("reverso: request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("reverso: returned status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Result{}, fmt.Errorf("reverso: read response error: %w", err)
+	}
+
+	var parsed struct {
+		Translation []string `json:"translation"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return Result{}, fmt.Errorf("reverso: JSON unmarshal error: %w", err)
+	}
+	if len(parsed.Translation) == 0 {
+		return Result{}, fmt.Errorf("reverso: empty translation")
+	}
+
+	result := Result{TranslatedText: parsed.Translation[0], EngineUsed: "reverso"}
+	if len(parsed.Translation) > 1 {
+		result.Alternatives = parsed.Translation[1:]
+	}
+	return result, nil
+}
+
+// YandexEngine talks to Yandex Translate's public web endpoint.
+//
+// Unlike the other scraping-based engines, Yandex requires a session id
+// (sid) minted by first loading translate.yandex.com and scraping it out
+// of the page; that sid is then passed as a query parameter on every
+// subsequent translation GET. yandexSessionID bootstraps it once and caches it
+// for the life of the process, re-bootstrapping if a request starts
+// failing.
+type YandexEngine struct{}
+
+func (YandexEngine) Name() string { return "yandex" }
+
+var (
+	yandexSIDMu sync.Mutex
+	yandexSID   string
+)
+
+func yandexSessionID(ctx context.Context) (string, error) {
+	yandexSIDMu.Lock()
+	sid := yandexSID
+	yandexSIDMu.Unlock()
+	if sid != "" {
+		return sid, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://translate.yandex.com/", nil)
+	if err != nil {
+		return "", fmt.Errorf("yandex: create bootstrap request error: %w", err)
+	}
+
+	resp, err := DefaultClient.Do(ctx, "yandex", req)
+	if err != nil {
+		return "", fmt.Errorf("yandex: bootstrap request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("yandex: read bootstrap page error: %w", err)
+	}
+
+	const marker = "SID: '"
+	idx := bytes.Index(body, []byte(marker))
+	if idx == -1 {
+		return "", fmt.Errorf("yandex: could not locate session id in bootstrap page")
+	}
+	rest := body[idx+len(marker):]
+	end := bytes.IndexByte(rest, '\'')
+	if end == -1 {
+		return "", fmt.Errorf("yandex: malformed session id in bootstrap page")
+	}
+
+	sid = string(rest[:end])
+	yandexSIDMu.Lock()
+	yandexSID = sid
+	yandexSIDMu.Unlock()
+	return sid, nil
+}
+
+func (YandexEngine) Translate(ctx context.Context, text, source, target string) (Result, error) {
+	sid, err := yandexSessionID(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+
+	apiURL := fmt.Sprintf("https://translate.yandex.net/api/v1/tr.json/translate?id=%s-0-0&srv=tr-text&lang=%s-%s&text=%s",
+		sid, source, target, url.QueryEscape(text))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return Result{}, fmt.Errorf("yandex: create request error: %w", err)
+	}
+
+	resp, err := DefaultClient.Do(ctx, "yandex", req)
+	if err != nil {
+		return Result{}, fmt.Errorf("yandex: request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// A stale session id is the most common cause of a non-200 here;
+		// drop it so the next call re-bootstraps.
+		yandexSIDMu.Lock()
+		yandexSID = ""
+		yandexSIDMu.Unlock()
+		return Result{}, fmt.Errorf("yandex: returned status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Result{}, fmt.Errorf("yandex: read response error: %w", err)
+	}
+
+	var parsed struct {
+		Lang string   `json:"lang"`
+		Text []string `json:"text"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return Result{}, fmt.Errorf("yandex: JSON unmarshal error: %w", err)
+	}
+	if len(parsed.Text) == 0 {
+		return Result{}, fmt.Errorf("yandex: empty translation")
+	}
+
+	detected := ""
+	if idx := strings.Index(parsed.Lang, "-"); idx != -1 {
+		detected = parsed.Lang[:idx]
+	}
+
+	return Result{
+		TranslatedText: strings.Join(parsed.Text, " "),
+		DetectedSource: detected,
+		EngineUsed:     "yandex",
+	}, nil
+}
+
+// BingEngine talks to Bing Translator's web endpoint. Bing's
+// ttranslatev3 endpoint is form-encoded rather than JSON and, like
+// Yandex's session id, also requires an IG/IID pair scraped from the
+// translator page; bingCreds bootstraps that pair once per process and
+// re-bootstraps if a request starts failing.
+type BingEngine struct{}
+
+func (BingEngine) Name() string { return "bing" }
+
+var (
+	bingCredsMu     sync.Mutex
+	bingIG, bingIID string
+)
+
+var (
+	bingIGPattern  = regexp.MustCompile(`IG:"([^"]+)"`)
+	bingIIDPattern = regexp.MustCompile(`data-iid="([^"]+)"`)
+)
+
+func bingCreds(ctx context.Context) (ig, iid string, err error) {
+	bingCredsMu.Lock()
+	ig, iid = bingIG, bingIID
+	bingCredsMu.Unlock()
+	if ig != "" && iid != "" {
+		return ig, iid, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://www.bing.com/translator", nil)
+	if err != nil {
+		return "", "", fmt.Errorf("bing: create bootstrap request error: %w", err)
+	}
+
+	resp, err := DefaultClient.Do(ctx, "bing", req)
+	if err != nil {
+		return "", "", fmt.Errorf("bing: bootstrap request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("bing: read bootstrap page error: %w", err)
+	}
+
+	igMatch := bingIGPattern.FindSubmatch(body)
+	iidMatch := bingIIDPattern.FindSubmatch(body)
+	if igMatch == nil || iidMatch == nil {
+		return "", "", fmt.Errorf("bing: could not locate IG/IID in bootstrap page")
+	}
+
+	ig, iid = string(igMatch[1]), string(iidMatch[1])
+	bingCredsMu.Lock()
+	bingIG, bingIID = ig, iid
+	bingCredsMu.Unlock()
+	return ig, iid, nil
+}
+
+func (BingEngine) Translate(ctx context.Context, text, source, target string) (Result, error) {
+	ig, iid, err := bingCreds(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+
+	apiURL := fmt.Sprintf("https://www.bing.com/ttranslatev3?isVertical=1&IG=%s&IID=%s", ig, iid)
+
+	form := url.Values{
+		"text":     {text},
+		"fromLang": {source},
+		"to":       {target},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewBufferString(form.Encode()))
+	if err != nil {
+		return Result{}, fmt.Errorf("bing: create request error: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := DefaultClient.Do(ctx, "bing", req)
+	if err != nil {
+		return Result{}, fmt.Errorf("bing: request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// A stale IG/IID pair is the most common cause of a non-200 here;
+		// drop it so the next call re-bootstraps.
+		bingCredsMu.Lock()
+		bingIG, bingIID = "", ""
+		bingCredsMu.Unlock()
+		return Result{}, fmt.Errorf("bing: returned status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Result{}, fmt.Errorf("bing: read response error: %w", err)
+	}
+
+	var parsed []struct {
+		DetectedLanguage struct {
+			Language string `json:"language"`
+		} `json:"detectedLanguage"`
+		Translations []struct {
+			Text string `json:"text"`
+			To   string `json:"to"`
+		} `json:"translations"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return Result{}, fmt.Errorf("bing: JSON unmarshal error: %w", err)
+	}
+	if len(parsed) == 0 || len(parsed[0].Translations) == 0 {
+		return Result{}, fmt.Errorf("bing: empty translation")
+	}
+
+	return Result{
+		TranslatedText: parsed[0].Translations[0].Text,
+		DetectedSource: parsed[0].DetectedLanguage.Language,
+		EngineUsed:     "bing",
+	}, nil
+}
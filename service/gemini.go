@@ -0,0 +1,220 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const geminiBaseURL = "https://generativelanguage.googleapis.com/v1beta/models"
+
+type geminiContent struct {
+	Parts []geminiPart `json:"parts"`
+	Role  string       `json:"role,omitempty"`
+}
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiRequestBody struct {
+	Contents []geminiContent `json:"contents"`
+}
+
+type geminiResponseBody struct {
+	Candidates []struct {
+		Content geminiContent `json:"content"`
+	} `json:"candidates"`
+}
+
+// GeminiConfig holds the configuration required to call the Gemini API.
+// APIKey must come from the environment; this service never ships a key in
+// source.
+type GeminiConfig struct {
+	APIKey string
+	Model  string
+}
+
+// LoadGeminiConfig reads GeminiConfig from the environment, returning an
+// error if GEMINI_API_KEY is unset. GEMINI_MODEL is optional and defaults
+// to gemini-2.5-pro-exp-03-25.
+func LoadGeminiConfig() (GeminiConfig, error) {
+	key := os.Getenv("GEMINI_API_KEY")
+	if key == "" {
+		return GeminiConfig{}, fmt.Errorf("GEMINI_API_KEY is not set")
+	}
+
+	model := os.Getenv("GEMINI_MODEL")
+	if model == "" {
+		model = "gemini-2.5-pro-exp-03-25"
+	}
+
+	return GeminiConfig{APIKey: key, Model: model}, nil
+}
+
+// GeminiClient calls the Gemini API, both the blocking :generateContent
+// endpoint and the streaming :streamGenerateContent?alt=sse endpoint.
+type GeminiClient struct {
+	Config GeminiConfig
+	Client *Client
+}
+
+// NewGeminiClient returns a GeminiClient using cfg and the package-wide
+// DefaultClient.
+func NewGeminiClient(cfg GeminiConfig) *GeminiClient {
+	return &GeminiClient{Config: cfg}
+}
+
+func (g *GeminiClient) client() *Client {
+	if g.Client != nil {
+		return g.Client
+	}
+	return DefaultClient
+}
+
+func (g *GeminiClient) url(streaming bool) string {
+	if streaming {
+		return fmt.Sprintf("%s/%s:streamGenerateContent?alt=sse&key=%s", geminiBaseURL, g.Config.Model, g.Config.APIKey)
+	}
+	return fmt.Sprintf("%s/%s:generateContent?key=%s", geminiBaseURL, g.Config.Model, g.Config.APIKey)
+}
+
+// Generate performs a single blocking call and returns the plain text of
+// the first candidate.
+func (g *GeminiClient) Generate(ctx context.Context, prompt string) (string, error) {
+	jsonData, err := marshalGeminiPrompt(prompt)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", g.url(false), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("gemini: create request error: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := g.client().Do(ctx, "gemini", req)
+	if err != nil {
+		return "", fmt.Errorf("gemini: request error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("gemini: read response error: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("gemini: API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var parsed geminiResponseBody
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("gemini: JSON unmarshal error: %w", err)
+	}
+	if len(parsed.Candidates) == 0 || len(parsed.Candidates[0].Content.Parts) == 0 {
+		return "", fmt.Errorf("gemini: no response candidates found")
+	}
+
+	return parsed.Candidates[0].Content.Parts[0].Text, nil
+}
+
+// StreamGenerate calls the streaming endpoint and forwards each text delta
+// on the returned channel as it arrives. Both channels are closed when the
+// response ends or ctx is canceled; a mid-stream failure is sent on errCh
+// before it closes.
+func (g *GeminiClient) StreamGenerate(ctx context.Context, prompt string) (<-chan string, <-chan error) {
+	deltas := make(chan string)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(deltas)
+		defer close(errCh)
+
+		jsonData, err := marshalGeminiPrompt(prompt)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "POST", g.url(true), bytes.NewBuffer(jsonData))
+		if err != nil {
+			errCh <- fmt.Errorf("gemini: create request error: %w", err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "text/event-stream")
+
+		resp, err := g.client().Do(ctx, "gemini", req)
+		if err != nil {
+			errCh <- fmt.Errorf("gemini: request error: %w", err)
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			errCh <- fmt.Errorf("gemini: API request failed with status %d: %s", resp.StatusCode, string(body))
+			return
+		}
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+			if !ok {
+				continue
+			}
+
+			var frame geminiResponseBody
+			if err := json.Unmarshal([]byte(data), &frame); err != nil {
+				continue
+			}
+			if len(frame.Candidates) == 0 {
+				continue
+			}
+
+			for _, part := range frame.Candidates[0].Content.Parts {
+				select {
+				case deltas <- part.Text:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			errCh <- fmt.Errorf("gemini: stream read error: %w", err)
+		}
+	}()
+
+	return deltas, errCh
+}
+
+func marshalGeminiPrompt(prompt string) ([]byte, error) {
+	reqBody := geminiRequestBody{Contents: []geminiContent{{Parts: []geminiPart{{Text: prompt}}}}}
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("gemini: JSON marshal error: %w", err)
+	}
+	return jsonData, nil
+}
+
+// GenerateExampleSentence asks Gemini for a single example sentence using
+// word in targetLang, returning the plain text of the first candidate. It
+// requires GEMINI_API_KEY to be set in the environment.
+func GenerateExampleSentence(ctx context.Context, word, targetLang string) (string, error) {
+	cfg, err := LoadGeminiConfig()
+	if err != nil {
+		return "", err
+	}
+
+	prompt := fmt.Sprintf("Give me one example sentence in %s using the word %q, in plain text and nothing else.", targetLang, word)
+	return NewGeminiClient(cfg).Generate(ctx, prompt)
+}
@@ -2,16 +2,23 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"time"
 )
 
-func LibreTranslateText(text, sourceLang, targetLang string) (string, error) {
+// LibreTranslateText talks to a self-hosted LibreTranslate instance.
+// Caching is handled one layer up, by Registry.Translate, so every engine
+// is covered uniformly rather than each implementing its own.
+func LibreTranslateText(ctx context.Context, c *Client, text, sourceLang, targetLang string) (string, error) {
+	if c == nil {
+		c = DefaultClient
+	}
+
 	apiURL := "http://deadhorse.net:5000/translate"
 	payload := map[string]any{
 		"q":      text,
@@ -24,7 +31,13 @@ func LibreTranslateText(text, sourceLang, targetLang string) (string, error) {
 		return "", fmt.Errorf("JSON marshal error: %w", err)
 	}
 
-	resp, err := http.Post(apiURL, "application/json", bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("create request error: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.Do(ctx, "libre", req)
 	if err != nil {
 		return "", fmt.Errorf("HTTP request error: %w", err)
 	}
@@ -48,7 +61,15 @@ func LibreTranslateText(text, sourceLang, targetLang string) (string, error) {
 	return translated, nil
 }
 
-func GoogleTranslateText(text, sourceLanguage, targetLanguage string) (string, error) {
+// GoogleTranslateText talks to Google Translate's public (undocumented)
+// translate_a/single endpoint. Caching is handled one layer up, by
+// Registry.Translate, so every engine is covered uniformly rather than each
+// implementing its own.
+func GoogleTranslateText(ctx context.Context, c *Client, text, sourceLanguage, targetLanguage string) (string, error) {
+	if c == nil {
+		c = DefaultClient
+	}
+
 	baseURL := "https://translate.google.com/translate_a/single"
 	params := url.Values{
 		"client": {"gtx"},
@@ -63,7 +84,7 @@ func GoogleTranslateText(text, sourceLanguage, targetLanguage string) (string, e
 	fullURL := fmt.Sprintf("%s?%s", baseURL, params.Encode())
 
 	// Create a request with appropriate headers to look like a browser
-	req, err := http.NewRequest("GET", fullURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fullURL, nil)
 	if err != nil {
 		return "", fmt.Errorf("create request error: %w", err)
 	}
@@ -72,11 +93,8 @@ func GoogleTranslateText(text, sourceLanguage, targetLanguage string) (string, e
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/webp,*/*;q=0.8")
 
-	// Create HTTP client with a timeout
-	client := &http.Client{Timeout: 30 * time.Second}
-
 	// Send request
-	resp, err := client.Do(req)
+	resp, err := c.Do(ctx, "google", req)
 	if err != nil {
 		return "", fmt.Errorf("translation request error: %w", err)
 	}
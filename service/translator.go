@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// Result is the normalized output of a translation engine.
+type Result struct {
+	TranslatedText string   `json:"translatedText"`
+	DetectedSource string   `json:"detectedSource,omitempty"`
+	Alternatives   []string `json:"alternatives,omitempty"`
+	EngineUsed     string   `json:"engineUsed"`
+}
+
+// Translator is implemented by every translation backend registered with
+// a Registry.
+type Translator interface {
+	Name() string
+	Translate(ctx context.Context, text, source, target string) (Result, error)
+}
+
+// Registry holds translation engines keyed by name and falls back from one
+// engine to the next, in registration order, when a translation attempt
+// fails.
+type Registry struct {
+	engines map[string]Translator
+	order   []string
+}
+
+// NewRegistry returns a Registry pre-populated with the built-in engines.
+func NewRegistry() *Registry {
+	r := &Registry{engines: make(map[string]Translator)}
+	r.Register(&GoogleEngine{})
+	r.Register(&LibreEngine{})
+	r.Register(&ReversoEngine{})
+	r.Register(&YandexEngine{})
+	r.Register(&BingEngine{})
+	return r
+}
+
+// Register adds an engine to the registry.
+func (r *Registry) Register(t Translator) {
+	if _, exists := r.engines[t.Name()]; !exists {
+		r.order = append(r.order, t.Name())
+	}
+	r.engines[t.Name()] = t
+}
+
+// Get returns the engine registered under name, if any.
+func (r *Registry) Get(name string) (Translator, bool) {
+	t, ok := r.engines[name]
+	return t, ok
+}
+
+// Translate dispatches to the named engine. If engine is empty, unknown,
+// or fails, Translate falls back through the remaining registered engines
+// in registration order until one succeeds. Each successful result is
+// cached against the process-wide default cache, keyed by the engine that
+// produced it; failures are not cached, so on a repeated lookup any engine
+// that failed before the serving one is called over the network again.
+func (r *Registry) Translate(ctx context.Context, engine, text, source, target string) (Result, error) {
+	tried := make(map[string]bool, len(r.order))
+	var lastErr error
+
+	if t, ok := r.engines[engine]; ok {
+		tried[engine] = true
+		if res, err := r.translateCached(ctx, t, text, source, target); err == nil {
+			return res, nil
+		} else {
+			lastErr = err
+		}
+	}
+
+	for _, name := range r.order {
+		if tried[name] {
+			continue
+		}
+		res, err := r.translateCached(ctx, r.engines[name], text, source, target)
+		if err == nil {
+			return res, nil
+		}
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no translation engines registered")
+	}
+	return Result{}, fmt.Errorf("all translation engines failed: %w", lastErr)
+}
+
+// translateCached checks the default cache for a prior result from t before
+// calling it, and populates the cache on a successful call.
+func (r *Registry) translateCached(ctx context.Context, t Translator, text, source, target string) (Result, error) {
+	key := TranslationKey(t.Name(), source, target, text)
+
+	var cached Result
+	if getDefaultCache().GetJSON(key, &cached) {
+		return cached, nil
+	}
+
+	res, err := t.Translate(ctx, text, source, target)
+	if err != nil {
+		return Result{}, err
+	}
+
+	getDefaultCache().SetJSON(key, res)
+	return res, nil
+}
@@ -1,14 +1,33 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"time"
 )
 
-func GenerateGoogleTTS(text, languageCode string) ([]byte, error) {
+func GenerateGoogleTTS(ctx context.Context, c *Client, text, languageCode string) ([]byte, error) {
+	key := TTSKey("google", languageCode, text)
+	if data, ok := getDefaultCache().Get(key); ok {
+		return data, nil
+	}
+
+	audioData, err := fetchGoogleTTS(ctx, c, text, languageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	getDefaultCache().Set(key, audioData)
+	return audioData, nil
+}
+
+func fetchGoogleTTS(ctx context.Context, c *Client, text, languageCode string) ([]byte, error) {
+	if c == nil {
+		c = DefaultClient
+	}
+
 	baseURL := "http://translate.google.com/translate_tts"
 	params := url.Values{
 		"ie":      {"UTF-8"},
@@ -22,7 +41,7 @@ func GenerateGoogleTTS(text, languageCode string) ([]byte, error) {
 	fullURL := fmt.Sprintf("%s?%s", baseURL, params.Encode())
 
 	// Create request with browser-like headers
-	req, err := http.NewRequest("GET", fullURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fullURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("create request error: %w", err)
 	}
@@ -35,13 +54,8 @@ func GenerateGoogleTTS(text, languageCode string) ([]byte, error) {
 	req.Header.Set("DNT", "1")
 	req.Header.Set("Connection", "keep-alive")
 
-	// Create HTTP client with a timeout
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
-
 	// Send request
-	resp, err := client.Do(req)
+	resp, err := c.Do(ctx, "google", req)
 	if err != nil {
 		return nil, fmt.Errorf("TTS request error: %w", err)
 	}